@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/codegangsta/cli"
+
+	forecast "github.com/mlbright/forecast/v2"
+
+	"github.com/alextoombs/goforecast/resolver"
+)
+
+// defaultConcurrency is used when --concurrency isn't passed.
+const defaultConcurrency = 4
+
+// batchResult is the outcome of resolving a single address, successful or
+// not.
+type batchResult struct {
+	Addr     string
+	Forecast *forecast.Forecast
+	Err      error
+}
+
+// addressesFromArgs collects the addresses to look up: either the file named
+// by --file, one per line, or the command's positional arguments.
+func addressesFromArgs(c *cli.Context) ([]string, error) {
+	if path := c.String("file"); path != "" {
+		return readAddressFile(path)
+	}
+	return []string(c.Args()), nil
+}
+
+// readAddressFile reads one address per line from path, skipping blank
+// lines.
+func readAddressFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var addrs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		addr := strings.TrimSpace(scanner.Text())
+		if addr == "" {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, scanner.Err()
+}
+
+// runBatch fetches forecasts for addrs concurrently across concurrency
+// workers, using the same resolver.Options for every address, and returning
+// one batchResult per address in the same order as addrs. A per-address
+// failure is captured on its batchResult rather than aborting the batch.
+// opts is derived from the CLI flags once by the caller, since it can carry
+// a *providers.Location resolved via *cli.Context.IsSet, which isn't safe
+// to call concurrently from worker goroutines.
+func runBatch(addrs []string, opts resolver.Options, concurrency int) []batchResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]batchResult, len(addrs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				addr := addrs[idx]
+				fc, err := getForecastForAddr(addr, opts)
+				results[idx] = batchResult{Addr: addr, Forecast: fc, Err: err}
+			}
+		}()
+	}
+
+	for i := range addrs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/alextoombs/goforecast/resolver"
+)
+
+func TestRunBatchOrdersResultsByInput(t *testing.T) {
+	opts := resolver.Options{Geocoder: "does-not-exist", Backend: "does-not-exist"}
+
+	addrs := []string{"one", "two", "three"}
+	results := runBatch(addrs, opts, 2)
+
+	if len(results) != len(addrs) {
+		t.Fatalf("Expected %d results, got %d", len(addrs), len(results))
+	}
+	for i, addr := range addrs {
+		if results[i].Addr != addr {
+			t.Fatalf("Expected result %d to be for %q, got %q", i, addr, results[i].Addr)
+		}
+		if results[i].Err == nil {
+			t.Fatalf("Expected result %d to have an error since no geocoder is registered", i)
+		}
+	}
+}
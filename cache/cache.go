@@ -0,0 +1,130 @@
+// Package cache memoizes geocoding and forecast responses on disk under
+// ~/.goforecast/cache/, keyed by caller-supplied namespace/key pairs, so
+// repeat lookups within a TTL don't cost an API call.
+package cache
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// dirName is the directory (under $HOME/.goforecast) that cache entries are
+// stored in.
+const dirName = ".goforecast/cache"
+
+// dir returns the cache directory, creating it if necessary.
+func dir() (string, error) {
+	d := filepath.Join(os.Getenv("HOME"), dirName)
+	if err := os.MkdirAll(d, 0755); err != nil {
+		return "", err
+	}
+	return d, nil
+}
+
+// entry is the on-disk representation of a cached value.
+type entry struct {
+	StoredAt int64           `json:"stored_at"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// path returns the file a namespace/key pair is stored at. Keys are hashed
+// so arbitrary strings (addresses, coordinates) are safe filenames.
+func path(namespace, key string) (string, error) {
+	d, err := dir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha1.Sum([]byte(namespace + ":" + key))
+	return filepath.Join(d, fmt.Sprintf("%s-%x.json", namespace, sum)), nil
+}
+
+// Get reads the cached value for namespace/key into out, reporting whether a
+// live (younger than ttl) entry was found.
+func Get(namespace, key string, ttl time.Duration, out interface{}) (bool, error) {
+	p, err := path(namespace, key)
+	if err != nil {
+		return false, err
+	}
+
+	b, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return false, err
+	}
+
+	if time.Since(time.Unix(e.StoredAt, 0)) > ttl {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(e.Value, out); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Set writes value to the cache under namespace/key, timestamped as of now.
+// It writes to a temp file and renames it into place so that concurrent
+// writers hitting the same key (e.g. a batch lookup with a duplicate
+// address) can't leave behind a truncated/corrupt entry.
+func Set(namespace, key string, value interface{}) error {
+	p, err := path(namespace, key)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(entry{StoredAt: time.Now().Unix(), Value: raw})
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(p), filepath.Base(p)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
+
+// Clear removes every cached entry.
+func Clear() error {
+	d, err := dir()
+	if err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(d)
+	if err != nil {
+		return err
+	}
+	for _, fi := range entries {
+		if err := os.Remove(filepath.Join(d, fi.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetSetRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	type payload struct {
+		Value string
+	}
+
+	if err := Set("test", "key", payload{Value: "hello"}); err != nil {
+		t.Fatalf("Set returned error: %s", err)
+	}
+
+	var got payload
+	ok, err := Get("test", "key", time.Hour, &got)
+	if err != nil {
+		t.Fatalf("Get returned error: %s", err)
+	}
+	if !ok {
+		t.Fatal("Expected a cache hit")
+	}
+	if got.Value != "hello" {
+		t.Fatalf("Expected value %q, got %q", "hello", got.Value)
+	}
+}
+
+func TestGetExpiredEntryMisses(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := Set("test", "key", "hello"); err != nil {
+		t.Fatalf("Set returned error: %s", err)
+	}
+
+	var got string
+	ok, err := Get("test", "key", -time.Second, &got)
+	if err != nil {
+		t.Fatalf("Get returned error: %s", err)
+	}
+	if ok {
+		t.Fatal("Expected a stale entry to miss")
+	}
+}
+
+func TestGetMissingKeyMisses(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var got string
+	ok, err := Get("test", "missing", time.Hour, &got)
+	if err != nil {
+		t.Fatalf("Get returned error: %s", err)
+	}
+	if ok {
+		t.Fatal("Expected a miss for a key that was never set")
+	}
+}
+
+func TestConcurrentSetSameKeyDoesNotCorrupt(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := Set("test", "key", i); err != nil {
+				t.Errorf("Set returned error: %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var got int
+	ok, err := Get("test", "key", time.Hour, &got)
+	if err != nil {
+		t.Fatalf("Get returned error: %s", err)
+	}
+	if !ok {
+		t.Fatal("Expected a cache hit after concurrent writes")
+	}
+}
+
+func TestClearRemovesEntries(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := Set("test", "key", "hello"); err != nil {
+		t.Fatalf("Set returned error: %s", err)
+	}
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear returned error: %s", err)
+	}
+
+	var got string
+	ok, err := Get("test", "key", time.Hour, &got)
+	if err != nil {
+		t.Fatalf("Get returned error: %s", err)
+	}
+	if ok {
+		t.Fatal("Expected no entries after Clear")
+	}
+
+	d, err := dir()
+	if err != nil {
+		t.Fatalf("dir returned error: %s", err)
+	}
+	entries, err := os.ReadDir(d)
+	if err != nil {
+		t.Fatalf("ReadDir returned error: %s", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("Expected empty cache dir after Clear, got %d entries", len(entries))
+	}
+}
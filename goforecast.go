@@ -1,37 +1,35 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
-	"net/url"
 	"os"
-	"path/filepath"
 
 	"github.com/codegangsta/cli"
 
 	forecast "github.com/mlbright/forecast/v2"
-)
-
-const (
-	// geocodeHost is the host at which we access the geocoding API.
-	// TODO(alex): allow option for HTTPS with key set up.
-	geocodeHost = "maps.googleapis.com/"
-	// geocodePath is the path at which we access the geocoding API.
-	geocodePath = "maps/api/geocode/json"
 
-	// forecastIoEnvKey is the environment variable key that should be set with
-	// the forecastIo API key.
-	forecastIoEnvKey = "FORECAST_IO_API_KEY"
+	"github.com/alextoombs/goforecast/cache"
+	"github.com/alextoombs/goforecast/providers"
+	"github.com/alextoombs/goforecast/resolver"
 
-	// goforecastState is the name of the file that contains the forecast io
-	// key.
-	goforecastState = ".goforecast"
+	// Blank-imported so their init functions register with the providers
+	// package; see providers.go for the registry itself.
+	_ "github.com/alextoombs/goforecast/providers/forecastio"
+	_ "github.com/alextoombs/goforecast/providers/google"
+	_ "github.com/alextoombs/goforecast/providers/openweathermap"
+	_ "github.com/alextoombs/goforecast/providers/osm"
 )
 
-// stateFilePath is where the goforecastState is located.
-var stateFilePath = os.Getenv("HOME")
+const (
+	// defaultGeocoder is used when --geocoder isn't passed.
+	defaultGeocoder = "google"
+	// defaultBackend is used when --backend isn't passed.
+	defaultBackend = "forecastio"
+	// defaultUnits is used when --units isn't passed.
+	defaultUnits = "us"
+	// defaultDays is used when --days isn't passed to the forecast command.
+	defaultDays = 5
+)
 
 func main() {
 	app := setupCliApp()
@@ -54,8 +52,9 @@ func printError(err error) {
 func setupCliApp() *cli.App {
 	app := cli.NewApp()
 	app.Name = "goforecast"
-	app.Usage = `goforecast looks up three days of weather based upon a partial
-	    address; e.g., a zip code.`
+	app.Usage = `goforecast looks up weather based upon a partial address; e.g., a
+	    zip code. Use "lookup" for current conditions or "forecast" for a
+	    multi-day outlook.`
 	app.Author = "Alex Toombs"
 
 	populateCommands(app)
@@ -68,210 +67,161 @@ func populateCommands(app *cli.App) {
 		cli.Command{
 			Name:        "lookup",
 			ShortName:   "l",
-			Description: "`lookup` looks up weather for a partial or whole address.",
+			Description: "`lookup` looks up weather for one or more partial or whole addresses.",
 			Usage:       "lookup \"[address]...\"",
+			Flags: append(providerFlags(),
+				cli.StringFlag{
+					Name:  "file",
+					Usage: "read addresses to look up from a file, one per line",
+				},
+				cli.IntFlag{
+					Name:  "concurrency",
+					Value: defaultConcurrency,
+					Usage: "number of addresses to resolve at once",
+				},
+			),
 			Action: func(c *cli.Context) {
-				if len(c.Args()) == 0 {
-					printError(fmt.Errorf("missing address"))
-				}
-
-				addr := c.Args().First()
-				u, err := buildGeocodingURL("http", parseGeocodingAddr(addr))
+				addrs, err := addressesFromArgs(c)
 				if err != nil {
 					printError(err)
+					return
+				}
+				if len(addrs) == 0 {
+					printError(fmt.Errorf("missing address"))
+					return
 				}
 
-				geoResp, err := getGeocodingLocation(http.DefaultClient, u)
-				if err != nil {
-					printError(err)
+				results := runBatch(addrs, resolverOptionsFromFlags(c), c.Int("concurrency"))
+				renderBatch(results, renderOptions{Units: c.String("units")})
+			},
+		},
+		cli.Command{
+			Name:        "forecast",
+			ShortName:   "f",
+			Description: "`forecast` renders a multi-day extended outlook for a partial or whole address.",
+			Usage:       "forecast \"[address]\"",
+			Flags: append(providerFlags(),
+				cli.IntFlag{
+					Name:  "days",
+					Value: defaultDays,
+					Usage: "number of days to include in the outlook",
+				},
+				cli.BoolFlag{
+					Name:  "hourly",
+					Usage: "also render an hourly summary",
+				},
+			),
+			Action: func(c *cli.Context) {
+				if len(c.Args()) == 0 {
+					printError(fmt.Errorf("missing address"))
 				}
 
-				fc, err := getForecast(geoResp.Results[0].Geometry.Location)
+				addr := c.Args().First()
+
+				fc, err := getForecastForAddr(addr, resolverOptionsFromFlags(c))
 				if err != nil {
 					printError(err)
+					return
 				}
 
-				renderForecast(fc, addr)
+				renderForecast(fc, addr, renderOptions{
+					Units:  c.String("units"),
+					Days:   c.Int("days"),
+					Hourly: c.Bool("hourly"),
+				})
 			},
 		},
+		cli.Command{
+			Name:        "cache",
+			Description: "`cache` manages the on-disk response cache.",
+			Subcommands: []cli.Command{
+				cli.Command{
+					Name:        "clear",
+					Description: "`cache clear` removes every cached geocoding and forecast response.",
+					Action: func(c *cli.Context) {
+						if err := cache.Clear(); err != nil {
+							printError(err)
+						}
+					},
+				},
+			},
+		},
+		serveCommand(),
 	}
 }
 
-// buildGeocodingURL builds a parsed URL with query values passed in.
-func buildGeocodingURL(scheme string, vals url.Values) (*url.URL, error) {
-	u := &url.URL{
-		Scheme: scheme,
-		Host:   geocodeHost,
-		Path:   geocodePath,
-	}
-	if len(vals) != 0 {
-		u.RawQuery = vals.Encode()
-
-	}
-	return url.Parse(u.String())
-}
-
-// parseGeocodingAddr parses a string address into url query values.
-func parseGeocodingAddr(addr string) url.Values {
-	vals := url.Values{}
-	vals.Add("address", url.QueryEscape(addr))
-	vals.Add("sensor", "false")
-	return vals
-}
-
-// getGeocodingLocation attempts to get a valid geocoding response back for the
-// entered address.
-func getGeocodingLocation(client *http.Client, u *url.URL) (*geocodingResponse, error) {
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	errStr := "on request: got code %d"
-	switch resp.StatusCode {
-	case http.StatusOK:
-	case http.StatusCreated:
-	default:
-		return nil, fmt.Errorf(errStr, resp.StatusCode)
-	}
-
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	geoResp := new(geocodingResponse)
-	if err := json.Unmarshal(b, &geoResp); err != nil {
-		return nil, err
-	}
-
-	if len(geoResp.Results) == 0 {
-		return nil, fmt.Errorf("no geocoding results returned")
-	}
-	return geoResp, nil
-}
-
-// geocodingResponse is a golang struct that some fields of a geocoding response
-// will cleanly unmarshal onto.
-type geocodingResponse struct {
-	Results []geocodingResult `json:"results"`
-}
-
-// geocodingResult is one result from the geocoding lookup.
-type geocodingResult struct {
-	Geometry *geometry `json:"geometry"`
-}
-
-// geometry embeds a ocation.
-type geometry struct {
-	Location *location `json:"location"`
-}
-
-// location is a latitude/longitutde pair.
-type location struct {
-	Lat float64 `json:"lat"`
-	Lng float64 `json:"lng"`
-}
-
-// getForecast gets the forecast from forecast.io.
-func getForecast(loc *location) (*forecast.Forecast, error) {
-	key, err := getForecastIOKey()
-	if err != nil {
-		return nil, err
-	}
-
-	// Record state.
-	if err := dumpState(&forecastIoState{
-		ApiKey: key,
-	}); err != nil {
-		return nil, err
+// providerFlags returns the provider/units/cache/coordinate flags shared by
+// every command that resolves an address to a forecast.
+func providerFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "geocoder",
+			Value: defaultGeocoder,
+			Usage: fmt.Sprintf("geocoder to use (one of: %v)", providers.Geocoders()),
+		},
+		cli.StringFlag{
+			Name:  "backend",
+			Value: defaultBackend,
+			Usage: fmt.Sprintf("weather backend to use (one of: %v)", providers.Backends()),
+		},
+		cli.StringFlag{
+			Name:  "units",
+			Value: defaultUnits,
+			Usage: "units to render the forecast in (one of: us, si, ca, uk, auto)",
+		},
+		cli.BoolFlag{
+			Name:  "no-cache",
+			Usage: "don't read or write the on-disk response cache",
+		},
+		cli.BoolFlag{
+			Name:  "refresh",
+			Usage: "bypass the cache for this request, but still update it",
+		},
+		cli.StringFlag{
+			Name:  "country",
+			Usage: "ISO 3166-1 alpha-2 country hint for the geocoder (e.g. US, CA, GB)",
+		},
+		cli.Float64Flag{
+			Name:  "lat",
+			Usage: "latitude to fetch a forecast for, bypassing geocoding (requires --lng)",
+		},
+		cli.Float64Flag{
+			Name:  "lng",
+			Usage: "longitude to fetch a forecast for, bypassing geocoding (requires --lat)",
+		},
 	}
-
-	// TODO(alex): allow for multiple time windows and units.
-	return forecast.Get(key,
-		fmt.Sprintf("%.2f", loc.Lat),
-		fmt.Sprintf("%.2f", loc.Lng),
-		"now",
-		forecast.US,
-	)
-}
-
-// renderForecast nicely displays the forecast.
-// TODO(alex): more information rendered!
-// TODO(alex): rendered units should be dynamic.
-func renderForecast(fc *forecast.Forecast, addr string) {
-	fmt.Printf("Displaying current forecast for %s\n\n", addr)
-
-	fmt.Println("---Currently---")
-	fmt.Printf("Summary: %s\n\n", fc.Currently.Summary)
-	fmt.Printf("Temperature: %.2f F\n", fc.Currently.Temperature)
-	fmt.Printf("Pressure: %.2f kPa\n", fc.Currently.Pressure)
-	fmt.Printf("Wind Speed: %.2f mph\n", fc.Currently.WindSpeed)
-	fmt.Printf("Precipitation Chance: %.2f%%\n", fc.Currently.PrecipProbability)
 }
 
-// forecastIoState is a json struct that we read from/write to disk to keep
-// state.
-type forecastIoState struct {
-	ApiKey string `json:"api_key"`
+// getForecastForAddr geocodes addr and fetches a forecast for it per opts,
+// consulting the on-disk cache before making either network call. If
+// opts.Location is set, geocoding is bypassed entirely and addr is ignored.
+func getForecastForAddr(addr string, opts resolver.Options) (*forecast.Forecast, error) {
+	_, fc, err := resolver.Resolve(addr, opts)
+	return fc, err
 }
 
-// getForecastIoKey looks up the Forecast IO API key from disk or the user's
-// environment.
-func getForecastIOKey() (string, error) {
-	state, err := restoreState()
-	if err != nil && !os.IsNotExist(err) {
-		return "", err
+// resolverOptionsFromFlags reads the provider/units/cache/coordinate flags
+// off c into a resolver.Options. It must be called once per command
+// invocation, not from inside a worker goroutine: *cli.Context.IsSet
+// (used by latLngFromFlags) lazily populates an internal map with no
+// locking, so calling it concurrently on a shared Context is a data race.
+func resolverOptionsFromFlags(c *cli.Context) resolver.Options {
+	return resolver.Options{
+		Geocoder: c.String("geocoder"),
+		Backend:  c.String("backend"),
+		Units:    c.String("units"),
+		Country:  c.String("country"),
+		Location: latLngFromFlags(c),
+		NoCache:  c.Bool("no-cache"),
+		Refresh:  c.Bool("refresh"),
 	}
-
-	if state != nil && state.ApiKey != "" {
-		return state.ApiKey, nil
-	}
-
-	// Try environment.
-	k := os.Getenv(forecastIoEnvKey)
-	if k == "" {
-		return "", fmt.Errorf("could not find Forecast IO API key. Please set with \"export %s=<key>\"",
-			forecastIoEnvKey)
-	}
-	return k, nil
 }
 
-// restoreState restores state from disk.
-func restoreState() (*forecastIoState, error) {
-	f, err := os.Open(filepath.Join(stateFilePath, goforecastState))
-	if err != nil {
-		return nil, err
-	}
-	defer f.Close()
-
-	b, err := ioutil.ReadAll(f)
-	if err != nil {
-		return nil, err
-	}
-
-	var state *forecastIoState
-	if err := json.Unmarshal(b, &state); err != nil {
-		return nil, err
-	}
-	return state, nil
-}
-
-// dumpState writes state to disk.
-func dumpState(state *forecastIoState) error {
-	b, err := json.Marshal(state)
-	if err != nil {
-		return err
-	}
-
-	if err := ioutil.WriteFile(filepath.Join(stateFilePath, goforecastState), b, 0644); err != nil {
-		return err
+// latLngFromFlags returns the explicit coordinates given via --lat/--lng, or
+// nil if either is unset.
+func latLngFromFlags(c *cli.Context) *providers.Location {
+	if !c.IsSet("lat") || !c.IsSet("lng") {
+		return nil
 	}
-	return nil
+	return &providers.Location{Lat: c.Float64("lat"), Lng: c.Float64("lng")}
 }
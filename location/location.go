@@ -0,0 +1,81 @@
+// Package location classifies a user-supplied location string so callers
+// can route it to a dedicated postal-code geocoding endpoint instead of the
+// generic address geocoder, which frequently returns city-centroid
+// coordinates for ambiguous ZIPs.
+package location
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Kind identifies the shape of a location string.
+type Kind int
+
+const (
+	// KindAddress is a free-form (or partial) address.
+	KindAddress Kind = iota
+	// KindZIPUS is a 5-digit US ZIP code.
+	KindZIPUS
+	// KindZIPUS4 is a ZIP+4 US postal code.
+	KindZIPUS4
+	// KindPostalCA is a Canadian postal code.
+	KindPostalCA
+	// KindPostalUK is a UK postcode.
+	KindPostalUK
+)
+
+// String returns a human-readable name for k.
+func (k Kind) String() string {
+	switch k {
+	case KindZIPUS:
+		return "us-zip"
+	case KindZIPUS4:
+		return "us-zip4"
+	case KindPostalCA:
+		return "ca-postal"
+	case KindPostalUK:
+		return "uk-postal"
+	default:
+		return "address"
+	}
+}
+
+var (
+	zipUS4   = regexp.MustCompile(`^\d{5}-\d{4}$`)
+	zipUS    = regexp.MustCompile(`^\d{5}$`)
+	postalCA = regexp.MustCompile(`(?i)^[A-Z]\d[A-Z][ -]?\d[A-Z]\d$`)
+	postalUK = regexp.MustCompile(`(?i)^[A-Z]{1,2}\d[A-Z\d]?\s?\d[A-Z]{2}$`)
+)
+
+// Classify inspects input and reports what kind of location it is, along
+// with the query values a postal-code-aware geocoder should use to resolve
+// it: "postal_code" and "country" for a recognized postal code, or
+// "address" for anything else.
+func Classify(input string) (Kind, url.Values) {
+	trimmed := strings.TrimSpace(input)
+	vals := url.Values{}
+
+	switch {
+	case zipUS4.MatchString(trimmed):
+		vals.Set("postal_code", trimmed)
+		vals.Set("country", "US")
+		return KindZIPUS4, vals
+	case zipUS.MatchString(trimmed):
+		vals.Set("postal_code", trimmed)
+		vals.Set("country", "US")
+		return KindZIPUS, vals
+	case postalCA.MatchString(trimmed):
+		vals.Set("postal_code", trimmed)
+		vals.Set("country", "CA")
+		return KindPostalCA, vals
+	case postalUK.MatchString(trimmed):
+		vals.Set("postal_code", trimmed)
+		vals.Set("country", "GB")
+		return KindPostalUK, vals
+	default:
+		vals.Set("address", trimmed)
+		return KindAddress, vals
+	}
+}
@@ -0,0 +1,60 @@
+package location
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		wantKind    Kind
+		wantPostal  string
+		wantCountry string
+	}{
+		{"us zip", "94103", KindZIPUS, "94103", "US"},
+		{"us zip+4", "94103-1234", KindZIPUS4, "94103-1234", "US"},
+		{"ca postal", "K1A 0B1", KindPostalCA, "K1A 0B1", "CA"},
+		{"ca postal no space", "K1A0B1", KindPostalCA, "K1A0B1", "CA"},
+		{"uk postal", "SW1A 1AA", KindPostalUK, "SW1A 1AA", "GB"},
+		{"address", "1600 Amphitheatre Pkwy, Mountain View, CA", KindAddress, "", ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			kind, vals := Classify(c.input)
+			if kind != c.wantKind {
+				t.Fatalf("Classify(%q) kind = %v, want %v", c.input, kind, c.wantKind)
+			}
+			if c.wantKind == KindAddress {
+				if got := vals.Get("address"); got != c.input {
+					t.Fatalf("address = %q, want %q", got, c.input)
+				}
+				return
+			}
+			if got := vals.Get("postal_code"); got != c.wantPostal {
+				t.Fatalf("postal_code = %q, want %q", got, c.wantPostal)
+			}
+			if got := vals.Get("country"); got != c.wantCountry {
+				t.Fatalf("country = %q, want %q", got, c.wantCountry)
+			}
+		})
+	}
+}
+
+func TestKindString(t *testing.T) {
+	cases := []struct {
+		kind Kind
+		want string
+	}{
+		{KindAddress, "address"},
+		{KindZIPUS, "us-zip"},
+		{KindZIPUS4, "us-zip4"},
+		{KindPostalCA, "ca-postal"},
+		{KindPostalUK, "uk-postal"},
+	}
+
+	for _, c := range cases {
+		if got := c.kind.String(); got != c.want {
+			t.Fatalf("Kind(%d).String() = %q, want %q", c.kind, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Package forecastio implements a providers.WeatherBackend backed by the
+// forecast.io (Dark Sky) API.
+package forecastio
+
+import (
+	"fmt"
+
+	forecast "github.com/mlbright/forecast/v2"
+
+	"github.com/alextoombs/goforecast/providers"
+	"github.com/alextoombs/goforecast/state"
+)
+
+const (
+	// Name is the name this backend is registered under.
+	Name = "forecastio"
+
+	// envKey is the environment variable that should be set with the
+	// forecast.io API key.
+	envKey = "FORECAST_IO_API_KEY"
+)
+
+func init() {
+	providers.RegisterBackend(Name, &Backend{})
+}
+
+// Backend fetches forecasts from forecast.io.
+type Backend struct{}
+
+// Forecast implements providers.WeatherBackend.
+func (b *Backend) Forecast(loc *providers.Location, opts providers.ForecastOptions) (*forecast.Forecast, error) {
+	key, err := state.Key(Name, envKey)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, fmt.Errorf("could not find forecast.io API key. Please set with \"export %s=<key>\"",
+			envKey)
+	}
+
+	t := opts.Time
+	if t == "" {
+		t = "now"
+	}
+
+	return forecast.Get(key,
+		fmt.Sprintf("%.2f", loc.Lat),
+		fmt.Sprintf("%.2f", loc.Lng),
+		t,
+		units(opts.Units),
+		forecast.English,
+	)
+}
+
+// units maps a user-facing units string onto a forecast.Units value,
+// defaulting to US customary units.
+func units(u string) forecast.Units {
+	switch u {
+	case "si":
+		return forecast.SI
+	case "ca":
+		return forecast.CA
+	case "uk":
+		return forecast.UK
+	case "auto":
+		return forecast.AUTO
+	default:
+		return forecast.US
+	}
+}
@@ -0,0 +1,139 @@
+// Package google implements a providers.Geocoder backed by the Google Maps
+// geocoding API.
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/alextoombs/goforecast/location"
+	"github.com/alextoombs/goforecast/providers"
+	"github.com/alextoombs/goforecast/state"
+)
+
+const (
+	// Name is the name this geocoder is registered under.
+	Name = "google"
+
+	// envKey is the environment variable that may hold the Google Maps API
+	// key. buildURL attaches a key only when one is configured.
+	envKey = "GOOGLE_MAPS_API_KEY"
+
+	// host is the host at which we access the geocoding API.
+	host = "maps.googleapis.com"
+	// path is the path at which we access the geocoding API.
+	path = "maps/api/geocode/json"
+)
+
+func init() {
+	providers.RegisterGeocoder(Name, &Geocoder{})
+}
+
+// Geocoder geocodes addresses against the Google Maps geocoding API.
+type Geocoder struct{}
+
+// Geocode implements providers.Geocoder.
+func (g *Geocoder) Geocode(client *http.Client, addr string, country string) (*providers.Location, error) {
+	u, err := buildURL(addr, country)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return nil, &providers.UpstreamError{StatusCode: resp.StatusCode}
+	default:
+		return nil, fmt.Errorf("on request: got code %d", resp.StatusCode)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	geoResp := new(geocodingResponse)
+	if err := json.Unmarshal(b, geoResp); err != nil {
+		return nil, err
+	}
+
+	if len(geoResp.Results) == 0 {
+		return nil, providers.ErrNoResults
+	}
+
+	loc := geoResp.Results[0].Geometry.Location
+	return &providers.Location{Lat: loc.Lat, Lng: loc.Lng}, nil
+}
+
+// buildURL builds the geocoding request URL for addr, attaching an API key
+// if one has been configured. Addresses that look like a postal code are
+// routed through the components filter instead of the free-form address
+// parameter, since Google's address geocoder tends to return an
+// imprecise city centroid for a bare ZIP. country, if non-empty,
+// overrides the country location.Classify inferred from addr.
+func buildURL(addr string, country string) (*url.URL, error) {
+	kind, classified := location.Classify(addr)
+
+	vals := url.Values{}
+	if kind == location.KindAddress {
+		vals.Add("address", addr)
+	} else {
+		if country != "" {
+			classified.Set("country", country)
+		}
+		vals.Add("components", fmt.Sprintf("postal_code:%s|country:%s", classified.Get("postal_code"), classified.Get("country")))
+	}
+	vals.Add("sensor", "false")
+
+	key, err := state.Key(Name, envKey)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		vals.Add("key", key)
+	}
+
+	u := &url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     path,
+		RawQuery: vals.Encode(),
+	}
+	return url.Parse(u.String())
+}
+
+// geocodingResponse is a golang struct that some fields of a geocoding
+// response will cleanly unmarshal onto.
+type geocodingResponse struct {
+	Results []geocodingResult `json:"results"`
+}
+
+// geocodingResult is one result from the geocoding lookup.
+type geocodingResult struct {
+	Geometry *geometry `json:"geometry"`
+}
+
+// geometry embeds a location.
+type geometry struct {
+	Location *geocodingLocation `json:"location"`
+}
+
+// geocodingLocation is a latitude/longitude pair, as returned by Google.
+type geocodingLocation struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
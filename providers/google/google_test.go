@@ -0,0 +1,45 @@
+package google
+
+import "testing"
+
+func TestBuildURLAddress(t *testing.T) {
+	u, err := buildURL("1600 Amphitheatre Pkwy, Mountain View, CA", "")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+	if u == nil {
+		t.Fatal("URL should never be nil without error")
+	}
+
+	if got := u.Host; got != host {
+		t.Fatalf("Expected url host of %s, got: %s", host, got)
+	}
+	if want := "/" + path; u.Path != want {
+		t.Fatalf("Expected url path of %s, got: %s", want, u.Path)
+	}
+	if got := u.Query().Get("address"); got != "1600 Amphitheatre Pkwy, Mountain View, CA" {
+		t.Fatalf("Expected address query param, got: %s", got)
+	}
+}
+
+func TestBuildURLZIP(t *testing.T) {
+	u, err := buildURL("94109", "")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	if want := "postal_code:94109|country:US"; u.Query().Get("components") != want {
+		t.Fatalf("Expected components of %s, got: %s", want, u.Query().Get("components"))
+	}
+}
+
+func TestBuildURLZIPCountryOverride(t *testing.T) {
+	u, err := buildURL("94109", "CA")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	if want := "postal_code:94109|country:CA"; u.Query().Get("components") != want {
+		t.Fatalf("Expected components of %s, got: %s", want, u.Query().Get("components"))
+	}
+}
@@ -0,0 +1,201 @@
+// Package openweathermap implements a providers.WeatherBackend backed by
+// the OpenWeatherMap One Call API, normalized onto the same
+// forecast.Forecast shape the forecastio backend returns. This is what
+// keeps goforecast working if forecast.io ever gets retired, as it has for
+// other similar tools.
+package openweathermap
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	forecast "github.com/mlbright/forecast/v2"
+
+	"github.com/alextoombs/goforecast/providers"
+	"github.com/alextoombs/goforecast/state"
+)
+
+const (
+	// Name is the name this backend is registered under.
+	Name = "openweathermap"
+
+	// envKey is the environment variable that should be set with the
+	// OpenWeatherMap API key.
+	envKey = "OPENWEATHERMAP_API_KEY"
+
+	// host is the host at which we access the One Call API.
+	host = "api.openweathermap.org"
+	// path is the path at which we access the One Call API.
+	path = "data/2.5/onecall"
+)
+
+func init() {
+	providers.RegisterBackend(Name, &Backend{})
+}
+
+// Backend fetches forecasts from OpenWeatherMap.
+type Backend struct{}
+
+// Forecast implements providers.WeatherBackend.
+func (b *Backend) Forecast(loc *providers.Location, opts providers.ForecastOptions) (*forecast.Forecast, error) {
+	if opts.Units == "uk" {
+		return nil, fmt.Errorf("openweathermap backend does not support \"uk\" units (Celsius + mph); use \"si\" or \"us\" instead")
+	}
+
+	key, err := state.Key(Name, envKey)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, fmt.Errorf("could not find OpenWeatherMap API key. Please set with \"export %s=<key>\"",
+			envKey)
+	}
+
+	vals := url.Values{}
+	vals.Add("lat", fmt.Sprintf("%.4f", loc.Lat))
+	vals.Add("lon", fmt.Sprintf("%.4f", loc.Lng))
+	vals.Add("units", units(opts.Units))
+	vals.Add("appid", key)
+
+	u := &url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     path,
+		RawQuery: vals.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return nil, &providers.UpstreamError{StatusCode: resp.StatusCode}
+	default:
+		return nil, fmt.Errorf("on request: got code %d", resp.StatusCode)
+	}
+
+	b2, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	owResp := new(oneCallResponse)
+	if err := json.Unmarshal(b2, owResp); err != nil {
+		return nil, err
+	}
+
+	return owResp.toForecast(), nil
+}
+
+// units maps a user-facing units string onto an OpenWeatherMap units value.
+// "uk" is rejected by Forecast before this is reached: OpenWeatherMap has no
+// mode combining Celsius with mph.
+func units(u string) string {
+	switch u {
+	case "si", "ca":
+		return "metric"
+	default:
+		return "imperial"
+	}
+}
+
+// oneCallResponse is the subset of OpenWeatherMap's One Call API response
+// that we normalize onto a forecast.Forecast. current.temp and daily[].temp
+// have different shapes in the real API - a bare number for the former, an
+// object with day/min/max for the latter - so they're separate types rather
+// than one dataPoint shared across both.
+type oneCallResponse struct {
+	Lat     float64      `json:"lat"`
+	Lon     float64      `json:"lon"`
+	Current currentPoint `json:"current"`
+	Daily   []dailyPoint `json:"daily"`
+}
+
+// weatherDesc is OpenWeatherMap's short weather description, shared by both
+// current and daily data points.
+type weatherDesc struct {
+	Main string `json:"main"`
+	Desc string `json:"description"`
+}
+
+// dataPoint holds the fields common to both a current and a daily
+// OpenWeatherMap data point.
+type dataPoint struct {
+	Dt        int64         `json:"dt"`
+	Sunrise   int64         `json:"sunrise"`
+	Sunset    int64         `json:"sunset"`
+	Pressure  float64       `json:"pressure"`
+	WindSpeed float64       `json:"wind_speed"`
+	Pop       float64       `json:"pop"`
+	Weather   []weatherDesc `json:"weather"`
+}
+
+// summary returns OpenWeatherMap's short weather description, if any.
+func (d *dataPoint) summary() string {
+	if len(d.Weather) == 0 {
+		return ""
+	}
+	return d.Weather[0].Desc
+}
+
+// currentPoint is OpenWeatherMap's "current" data point, whose temp is a
+// bare number.
+type currentPoint struct {
+	dataPoint
+	Temp float64 `json:"temp"`
+}
+
+// dailyPoint is one entry of OpenWeatherMap's "daily" data point, whose temp
+// is an object of day/min/max readings.
+type dailyPoint struct {
+	dataPoint
+	Temp struct {
+		Day float64 `json:"day"`
+		Min float64 `json:"min"`
+		Max float64 `json:"max"`
+	} `json:"temp"`
+}
+
+// toForecast normalizes an OpenWeatherMap response onto a forecast.Forecast,
+// so callers don't need to know which backend they're talking to.
+func (r *oneCallResponse) toForecast() *forecast.Forecast {
+	fc := &forecast.Forecast{
+		Latitude:  r.Lat,
+		Longitude: r.Lon,
+	}
+
+	fc.Currently = forecast.DataPoint{
+		Time:              r.Current.Dt,
+		Summary:           r.Current.summary(),
+		Pressure:          r.Current.Pressure,
+		WindSpeed:         r.Current.WindSpeed,
+		PrecipProbability: r.Current.Pop,
+		Temperature:       r.Current.Temp,
+	}
+
+	for _, d := range r.Daily {
+		fc.Daily.Data = append(fc.Daily.Data, forecast.DataPoint{
+			Time:              d.Dt,
+			Summary:           d.summary(),
+			SunriseTime:       d.Sunrise,
+			SunsetTime:        d.Sunset,
+			TemperatureMax:    d.Temp.Max,
+			TemperatureMin:    d.Temp.Min,
+			PrecipProbability: d.Pop,
+		})
+	}
+
+	return fc
+}
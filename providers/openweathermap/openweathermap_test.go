@@ -0,0 +1,81 @@
+package openweathermap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alextoombs/goforecast/providers"
+)
+
+// realisticOneCallResponse mirrors the documented shape of a One Call API
+// response: current.temp is a bare number, daily[].temp is an object.
+const realisticOneCallResponse = `{
+	"lat": 37.7749,
+	"lon": -122.4194,
+	"current": {
+		"dt": 1690000000,
+		"sunrise": 1689990000,
+		"sunset": 1690040000,
+		"pressure": 1015,
+		"wind_speed": 5.5,
+		"pop": 0.1,
+		"temp": 68.5,
+		"weather": [{"main": "Clear", "description": "clear sky"}]
+	},
+	"daily": [
+		{
+			"dt": 1690000000,
+			"sunrise": 1689990000,
+			"sunset": 1690040000,
+			"pressure": 1015,
+			"wind_speed": 6.1,
+			"pop": 0.2,
+			"temp": {"day": 70.1, "min": 58.2, "max": 74.3},
+			"weather": [{"main": "Clouds", "description": "few clouds"}]
+		}
+	]
+}`
+
+func TestUnmarshalOneCallResponse(t *testing.T) {
+	owResp := new(oneCallResponse)
+	if err := json.Unmarshal([]byte(realisticOneCallResponse), owResp); err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	if got := owResp.Current.Temp; got != 68.5 {
+		t.Fatalf("Expected current temp of 68.5, got: %v", got)
+	}
+	if got := owResp.Daily[0].Temp.Max; got != 74.3 {
+		t.Fatalf("Expected daily max temp of 74.3, got: %v", got)
+	}
+}
+
+func TestToForecast(t *testing.T) {
+	owResp := new(oneCallResponse)
+	if err := json.Unmarshal([]byte(realisticOneCallResponse), owResp); err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	fc := owResp.toForecast()
+	if got := fc.Currently.Temperature; got != 68.5 {
+		t.Fatalf("Expected current temperature of 68.5, got: %v", got)
+	}
+	if got := fc.Currently.Summary; got != "clear sky" {
+		t.Fatalf("Expected current summary of %q, got: %q", "clear sky", got)
+	}
+	if got := fc.Daily.Data[0].TemperatureMax; got != 74.3 {
+		t.Fatalf("Expected daily max temperature of 74.3, got: %v", got)
+	}
+	if got := fc.Daily.Data[0].TemperatureMin; got != 58.2 {
+		t.Fatalf("Expected daily min temperature of 58.2, got: %v", got)
+	}
+}
+
+func TestForecastRejectsUKUnits(t *testing.T) {
+	b := &Backend{}
+	loc := &providers.Location{Lat: 37.7749, Lng: -122.4194}
+
+	if _, err := b.Forecast(loc, providers.ForecastOptions{Units: "uk"}); err == nil {
+		t.Fatal("Expected an error for \"uk\" units, got none")
+	}
+}
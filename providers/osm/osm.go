@@ -0,0 +1,123 @@
+// Package osm implements a providers.Geocoder backed by the OpenStreetMap
+// Nominatim search API. Unlike Google Maps, it requires no API key, which
+// makes it a reasonable fallback when a key hasn't been configured.
+package osm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/alextoombs/goforecast/location"
+	"github.com/alextoombs/goforecast/providers"
+)
+
+const (
+	// Name is the name this geocoder is registered under.
+	Name = "osm"
+
+	// host is the host at which we access the Nominatim search API.
+	host = "nominatim.openstreetmap.org"
+	// path is the path at which we access the Nominatim search API.
+	path = "search"
+)
+
+func init() {
+	providers.RegisterGeocoder(Name, &Geocoder{})
+}
+
+// Geocoder geocodes addresses against the OpenStreetMap Nominatim API.
+type Geocoder struct{}
+
+// Geocode implements providers.Geocoder.
+func (g *Geocoder) Geocode(client *http.Client, addr string, country string) (*providers.Location, error) {
+	u := buildURL(addr, country)
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	// Nominatim's usage policy requires a descriptive User-Agent.
+	req.Header.Set("User-Agent", "goforecast")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return nil, &providers.UpstreamError{StatusCode: resp.StatusCode}
+	default:
+		return nil, fmt.Errorf("on request: got code %d", resp.StatusCode)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []searchResult
+	if err := json.Unmarshal(b, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, providers.ErrNoResults
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing latitude: %s", err)
+	}
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing longitude: %s", err)
+	}
+
+	return &providers.Location{Lat: lat, Lng: lng}, nil
+}
+
+// buildURL builds the Nominatim search request URL for addr. Addresses that
+// look like a postal code are routed through Nominatim's structured
+// postalcode/countrycodes params instead of the free-form q parameter,
+// mirroring google.go's use of location.Classify, since Nominatim's
+// free-text search tends to return an imprecise city centroid for a bare
+// ZIP. country, if non-empty, overrides the country location.Classify
+// inferred from addr.
+func buildURL(addr string, country string) *url.URL {
+	kind, classified := location.Classify(addr)
+
+	vals := url.Values{}
+	vals.Add("format", "json")
+	vals.Add("limit", "1")
+	if kind == location.KindAddress {
+		vals.Add("q", addr)
+	} else {
+		vals.Add("postalcode", classified.Get("postal_code"))
+		if country == "" {
+			country = classified.Get("country")
+		}
+	}
+	if country != "" {
+		vals.Add("countrycodes", country)
+	}
+
+	return &url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     path,
+		RawQuery: vals.Encode(),
+	}
+}
+
+// searchResult is one result from the Nominatim search API. Nominatim
+// returns coordinates as strings rather than numbers.
+type searchResult struct {
+	Lat string `json:"lat"`
+	Lon string `json:"lon"`
+}
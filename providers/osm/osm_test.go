@@ -0,0 +1,39 @@
+package osm
+
+import "testing"
+
+func TestBuildURLAddress(t *testing.T) {
+	u := buildURL("1600 Amphitheatre Pkwy, Mountain View, CA", "")
+
+	if got := u.Host; got != host {
+		t.Fatalf("Expected url host of %s, got: %s", host, got)
+	}
+	if u.Path != path {
+		t.Fatalf("Expected url path of %s, got: %s", path, u.Path)
+	}
+	if got := u.Query().Get("q"); got != "1600 Amphitheatre Pkwy, Mountain View, CA" {
+		t.Fatalf("Expected q query param, got: %s", got)
+	}
+}
+
+func TestBuildURLZIP(t *testing.T) {
+	u := buildURL("94109", "")
+
+	if got := u.Query().Get("postalcode"); got != "94109" {
+		t.Fatalf("Expected postalcode of 94109, got: %s", got)
+	}
+	if got := u.Query().Get("countrycodes"); got != "US" {
+		t.Fatalf("Expected countrycodes of US, got: %s", got)
+	}
+	if got := u.Query().Get("q"); got != "" {
+		t.Fatalf("Expected no q query param for a ZIP, got: %s", got)
+	}
+}
+
+func TestBuildURLZIPCountryOverride(t *testing.T) {
+	u := buildURL("94109", "CA")
+
+	if got := u.Query().Get("countrycodes"); got != "CA" {
+		t.Fatalf("Expected countrycodes of CA, got: %s", got)
+	}
+}
@@ -0,0 +1,109 @@
+// Package providers defines the pluggable backends goforecast can use to
+// turn an address into a location and a location into a forecast, plus a
+// name-based registry so the CLI can pick implementations at runtime.
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	forecast "github.com/mlbright/forecast/v2"
+)
+
+// Location is a latitude/longitude pair, as resolved by a Geocoder.
+type Location struct {
+	Lat float64
+	Lng float64
+}
+
+// ErrNoResults is returned by a Geocoder when an address resolved to zero
+// results, as opposed to a transport or upstream error.
+var ErrNoResults = errors.New("no geocoding results returned")
+
+// UpstreamError indicates that a provider's upstream HTTP dependency
+// returned a server error, as distinct from a malformed request or a
+// transport failure. Callers that need to surface a specific status (e.g.
+// the gRPC server) can check for it with errors.As.
+type UpstreamError struct {
+	StatusCode int
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("upstream returned status %d", e.StatusCode)
+}
+
+// Geocoder resolves a free-form (or partial) address into a Location.
+// country is an optional ISO 3166-1 alpha-2 hint (e.g. "US", "CA", "GB")
+// that narrows postal-code lookups; it may be empty, in which case a
+// Geocoder should infer it from addr where possible.
+type Geocoder interface {
+	Geocode(client *http.Client, addr string, country string) (*Location, error)
+}
+
+// ForecastOptions carries the user-facing knobs that affect how a
+// WeatherBackend renders its forecast.
+type ForecastOptions struct {
+	// Units is one of "us", "si", "ca", "uk", or "auto".
+	Units string
+	// Time is either "now" or a unix timestamp, per the forecast.io API.
+	Time string
+}
+
+// WeatherBackend fetches a normalized forecast for a Location.
+type WeatherBackend interface {
+	Forecast(loc *Location, opts ForecastOptions) (*forecast.Forecast, error)
+}
+
+var (
+	geocoders = map[string]Geocoder{}
+	backends  = map[string]WeatherBackend{}
+)
+
+// RegisterGeocoder makes a Geocoder available under name. It is meant to be
+// called from a provider package's init function.
+func RegisterGeocoder(name string, g Geocoder) {
+	geocoders[name] = g
+}
+
+// RegisterBackend makes a WeatherBackend available under name. It is meant
+// to be called from a provider package's init function.
+func RegisterBackend(name string, b WeatherBackend) {
+	backends[name] = b
+}
+
+// Geocoders returns the names of all registered geocoders.
+func Geocoders() []string {
+	names := make([]string, 0, len(geocoders))
+	for name := range geocoders {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Backends returns the names of all registered weather backends.
+func Backends() []string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	return names
+}
+
+// GetGeocoder looks up a registered Geocoder by name.
+func GetGeocoder(name string) (Geocoder, error) {
+	g, ok := geocoders[name]
+	if !ok {
+		return nil, fmt.Errorf("no geocoder registered under name %q (have: %v)", name, Geocoders())
+	}
+	return g, nil
+}
+
+// GetBackend looks up a registered WeatherBackend by name.
+func GetBackend(name string) (WeatherBackend, error) {
+	b, ok := backends[name]
+	if !ok {
+		return nil, fmt.Errorf("no weather backend registered under name %q (have: %v)", name, Backends())
+	}
+	return b, nil
+}
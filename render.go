@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	forecast "github.com/mlbright/forecast/v2"
+)
+
+// renderOptions controls how much of a forecast renderForecast prints.
+type renderOptions struct {
+	// Units selects the labels rendered alongside values; see labelsFor.
+	Units string
+	// Days is how many entries of the daily forecast to render, 0 for none.
+	Days int
+	// Hourly additionally renders a compact hourly summary.
+	Hourly bool
+}
+
+// renderForecast nicely displays the forecast: the current conditions
+// always, a multi-day outlook when opts.Days is set, and an hourly summary
+// when opts.Hourly is set.
+func renderForecast(fc *forecast.Forecast, addr string, opts renderOptions) {
+	labels := labelsFor(opts.Units)
+
+	fmt.Printf("Displaying current forecast for %s\n\n", addr)
+
+	fmt.Println("---Currently---")
+	fmt.Printf("Summary: %s\n\n", fc.Currently.Summary)
+	fmt.Printf("Temperature: %.2f %s\n", fc.Currently.Temperature, labels.Temp)
+	fmt.Printf("Pressure: %.2f %s\n", fc.Currently.Pressure, labels.Pressure)
+	fmt.Printf("Wind Speed: %.2f %s\n", fc.Currently.WindSpeed, labels.Wind)
+	fmt.Printf("Precipitation Chance: %.2f%%\n", fc.Currently.PrecipProbability)
+
+	if opts.Days > 0 {
+		renderDaily(fc.Daily.Data, opts.Days, labels)
+	}
+	if opts.Hourly {
+		renderHourly(fc.Hourly.Data, labels)
+	}
+}
+
+// renderBatch renders a grouped report for a batch of lookups, printing each
+// successful forecast and collecting failures to report afterward without
+// interrupting the successes.
+func renderBatch(results []batchResult, opts renderOptions) {
+	var failed []batchResult
+	for i, r := range results {
+		if r.Err != nil {
+			failed = append(failed, r)
+			continue
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		renderForecast(r.Forecast, r.Addr, opts)
+	}
+
+	if len(failed) == 0 {
+		return
+	}
+	fmt.Fprintln(os.Stderr, "\n---Errors---")
+	for _, r := range failed {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", r.Addr, r.Err)
+	}
+}
+
+// renderDaily prints a compact per-day table for up to days entries of data.
+func renderDaily(data []forecast.DataPoint, days int, labels unitLabels) {
+	if len(data) == 0 {
+		return
+	}
+	if days > len(data) {
+		days = len(data)
+	}
+
+	fmt.Printf("\n---%d-Day Outlook---\n", days)
+	fmt.Printf("%-12s%-8s%-8s%-10s%-10s%-10s\n", "Day", "High", "Low", "Precip", "Sunrise", "Sunset")
+	for _, d := range data[:days] {
+		fmt.Printf("%-12s%-8.1f%-8.1f%-10s%-10s%-10s\n",
+			time.Unix(d.Time, 0).Format("Mon 01/02"),
+			d.TemperatureMax,
+			d.TemperatureMin,
+			precipDesc(d),
+			formatClock(d.SunriseTime),
+			formatClock(d.SunsetTime),
+		)
+	}
+}
+
+// renderHourly prints a compact hourly summary.
+func renderHourly(data []forecast.DataPoint, labels unitLabels) {
+	if len(data) == 0 {
+		return
+	}
+
+	fmt.Println("\n---Hourly---")
+	fmt.Printf("%-10s%-10s%-10s\n", "Hour", "Temp", "Precip%")
+	for _, h := range data {
+		fmt.Printf("%-10s%-10.1f%-10.1f\n",
+			time.Unix(h.Time, 0).Format("15:04"),
+			h.Temperature,
+			h.PrecipProbability*100,
+		)
+	}
+}
+
+// precipDesc describes a day's precipitation, falling back to the chance of
+// any when no precip type was reported.
+func precipDesc(d forecast.DataPoint) string {
+	if d.PrecipType == "" {
+		return fmt.Sprintf("%.0f%%", d.PrecipProbability*100)
+	}
+	return fmt.Sprintf("%s %.0f%%", d.PrecipType, d.PrecipProbability*100)
+}
+
+// formatClock renders a unix timestamp as a bare HH:MM, or "-" if unset.
+func formatClock(unix int64) string {
+	if unix == 0 {
+		return "-"
+	}
+	return time.Unix(unix, 0).Format("15:04")
+}
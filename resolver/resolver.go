@@ -0,0 +1,117 @@
+// Package resolver implements the address-to-forecast pipeline (geocode,
+// consult the cache, fetch a forecast) shared by the CLI and the serve
+// command, so both go through the same caching and provider-selection
+// logic.
+package resolver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	forecast "github.com/mlbright/forecast/v2"
+
+	"github.com/alextoombs/goforecast/cache"
+	"github.com/alextoombs/goforecast/providers"
+)
+
+const (
+	// GeocodeTTL is how long a cached geocoding response stays fresh.
+	// Addresses don't move, so this can be generous.
+	GeocodeTTL = 30 * 24 * time.Hour
+	// ForecastTTL is how long a cached forecast response stays fresh,
+	// mirroring forecast.io's update cadence.
+	ForecastTTL = 10 * time.Minute
+)
+
+// Options selects the providers and cache behavior to resolve a forecast
+// with.
+type Options struct {
+	Geocoder string
+	Backend  string
+	Units    string
+	// Country is an optional ISO 3166-1 alpha-2 hint passed to the
+	// geocoder, used to disambiguate postal codes that are ambiguous
+	// without it.
+	Country string
+	// Location, if non-nil, bypasses geocoding entirely and is used as
+	// the coordinates to fetch a forecast for.
+	Location *providers.Location
+	// NoCache skips reading and writing the cache entirely.
+	NoCache bool
+	// Refresh skips reading the cache but still writes the fresh result.
+	Refresh bool
+}
+
+// Resolve geocodes addr and fetches a forecast for it, using the geocoder
+// and backend named in opts, consulting the on-disk cache before making
+// either network call.
+func Resolve(addr string, opts Options) (*providers.Location, *forecast.Forecast, error) {
+	useCache := !opts.NoCache && !opts.Refresh
+
+	var loc providers.Location
+	if opts.Location != nil {
+		loc = *opts.Location
+	} else {
+		geocoder, err := providers.GetGeocoder(opts.Geocoder)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		geocodeKey := opts.Geocoder + "|" + opts.Country + "|" + normalizeAddr(addr)
+		hit := false
+		if useCache {
+			hit, err = cache.Get("geocode", geocodeKey, GeocodeTTL, &loc)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if !hit {
+			l, err := geocoder.Geocode(http.DefaultClient, addr, opts.Country)
+			if err != nil {
+				return nil, nil, err
+			}
+			loc = *l
+			if !opts.NoCache {
+				if err := cache.Set("geocode", geocodeKey, loc); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+
+	backend, err := providers.GetBackend(opts.Backend)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fopts := providers.ForecastOptions{Units: opts.Units}
+	forecastKey := fmt.Sprintf("%s|%.2f,%.2f|%s|%s", opts.Backend, loc.Lat, loc.Lng, fopts.Units, fopts.Time)
+
+	var fc forecast.Forecast
+	if useCache {
+		if ok, err := cache.Get("forecast", forecastKey, ForecastTTL, &fc); err != nil {
+			return nil, nil, err
+		} else if ok {
+			return &loc, &fc, nil
+		}
+	}
+
+	got, err := backend.Forecast(&loc, fopts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !opts.NoCache {
+		if err := cache.Set("forecast", forecastKey, got); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &loc, got, nil
+}
+
+// normalizeAddr canonicalizes an address for use as a cache key, so
+// "94109", " 94109 ", and "94109\n" all hit the same cache entry.
+func normalizeAddr(addr string) string {
+	return strings.ToLower(strings.TrimSpace(addr))
+}
@@ -0,0 +1,12 @@
+package resolver
+
+import "testing"
+
+func TestNormalizeAddr(t *testing.T) {
+	if got, want := normalizeAddr(" 94109 "), "94109"; got != want {
+		t.Fatalf("Expected %q, got %q", want, got)
+	}
+	if got, want := normalizeAddr("San Francisco"), "san francisco"; got != want {
+		t.Fatalf("Expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/codegangsta/cli"
+	"google.golang.org/grpc"
+
+	"github.com/alextoombs/goforecast/providers"
+	"github.com/alextoombs/goforecast/server"
+	"github.com/alextoombs/goforecast/server/goforecastpb"
+)
+
+const (
+	// defaultGRPCAddr is used when --grpc-addr isn't passed to serve.
+	defaultGRPCAddr = ":8980"
+	// defaultHTTPAddr is used when --http-addr isn't passed to serve.
+	defaultHTTPAddr = ":8981"
+)
+
+// serveCommand exposes the geocode+forecast pipeline over gRPC and, via a
+// parallel net/http mux, over REST/JSON.
+func serveCommand() cli.Command {
+	return cli.Command{
+		Name:        "serve",
+		Description: "`serve` exposes goforecast over gRPC and REST/JSON.",
+		Usage:       "serve",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "geocoder",
+				Value: defaultGeocoder,
+				Usage: fmt.Sprintf("geocoder to use (one of: %v)", providers.Geocoders()),
+			},
+			cli.StringFlag{
+				Name:  "backend",
+				Value: defaultBackend,
+				Usage: fmt.Sprintf("weather backend to use (one of: %v)", providers.Backends()),
+			},
+			cli.StringFlag{
+				Name:  "grpc-addr",
+				Value: defaultGRPCAddr,
+				Usage: "address to serve gRPC on",
+			},
+			cli.StringFlag{
+				Name:  "http-addr",
+				Value: defaultHTTPAddr,
+				Usage: "address to serve REST/JSON on",
+			},
+		},
+		Action: func(c *cli.Context) {
+			srv := &server.Server{
+				Geocoder: c.String("geocoder"),
+				Backend:  c.String("backend"),
+			}
+
+			errc := make(chan error, 2)
+
+			go func() {
+				lis, err := net.Listen("tcp", c.String("grpc-addr"))
+				if err != nil {
+					errc <- err
+					return
+				}
+				gs := grpc.NewServer()
+				goforecastpb.RegisterGoforecastServer(gs, srv)
+				fmt.Printf("serving gRPC on %s\n", c.String("grpc-addr"))
+				errc <- gs.Serve(lis)
+			}()
+
+			go func() {
+				fmt.Printf("serving REST/JSON on %s\n", c.String("http-addr"))
+				errc <- http.ListenAndServe(c.String("http-addr"), server.RESTHandler(srv))
+			}()
+
+			printError(<-errc)
+		},
+	}
+}
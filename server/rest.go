@@ -0,0 +1,103 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alextoombs/goforecast/server/goforecastpb"
+)
+
+// RESTHandler serves the same Lookup/LookupBatch pipeline as the gRPC
+// service over REST/JSON, mirroring the status-code-to-HTTP-status mapping
+// grpc-gateway uses, without requiring a grpc-gateway dependency.
+func RESTHandler(srv *Server) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/lookup", func(w http.ResponseWriter, r *http.Request) {
+		handleLookup(w, r, srv)
+	})
+	mux.HandleFunc("/v1/lookup/batch", func(w http.ResponseWriter, r *http.Request) {
+		handleLookupBatch(w, r, srv)
+	})
+	return mux
+}
+
+func handleLookup(w http.ResponseWriter, r *http.Request, srv *Server) {
+	q := r.URL.Query()
+	req := &goforecastpb.LookupRequest{
+		Address: q.Get("address"),
+		Units:   q.Get("units"),
+		Days:    int32(atoiOr(q.Get("days"), 0)),
+	}
+
+	reply, err := srv.Lookup(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, reply)
+}
+
+// batchStream adapts http's one-shot JSON response onto the streaming
+// server interface LookupBatch expects, buffering replies so they can be
+// returned as a single JSON array.
+type batchStream struct {
+	goforecastpb.Goforecast_LookupBatchServer
+	replies []*goforecastpb.ForecastReply
+}
+
+func (b *batchStream) Send(reply *goforecastpb.ForecastReply) error {
+	b.replies = append(b.replies, reply)
+	return nil
+}
+
+func handleLookupBatch(w http.ResponseWriter, r *http.Request, srv *Server) {
+	var req goforecastpb.LookupBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	stream := &batchStream{}
+	if err := srv.LookupBatch(&req, stream); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, stream.replies)
+}
+
+// atoiOr parses s as an int, returning fallback if s is empty or invalid.
+func atoiOr(s string, fallback int) int {
+	if s == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fallback
+	}
+	return n
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError maps a gRPC status error onto the HTTP status grpc-gateway
+// would use for the same code.
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, map[string]string{"error": status.Convert(err).Message()})
+}
@@ -0,0 +1,137 @@
+// Package server exposes goforecast's geocode+forecast pipeline over gRPC,
+// reusing the same providers.Geocoder/WeatherBackend abstractions and
+// resolver.Resolve caching path as the CLI.
+package server
+
+import (
+	"context"
+	"errors"
+
+	forecast "github.com/mlbright/forecast/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alextoombs/goforecast/providers"
+	"github.com/alextoombs/goforecast/resolver"
+	"github.com/alextoombs/goforecast/server/goforecastpb"
+)
+
+// defaultDays is how many days of a forecast's daily outlook are returned
+// when a request doesn't specify one.
+const defaultDays = 5
+
+// Server implements goforecastpb.GoforecastServer against a fixed geocoder
+// and weather backend, selected when the serve command started.
+type Server struct {
+	goforecastpb.UnimplementedGoforecastServer
+
+	Geocoder string
+	Backend  string
+}
+
+// Lookup implements goforecastpb.GoforecastServer.
+func (s *Server) Lookup(ctx context.Context, req *goforecastpb.LookupRequest) (*goforecastpb.ForecastReply, error) {
+	if req.GetAddress() == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	loc, fc, err := resolver.Resolve(req.GetAddress(), resolver.Options{
+		Geocoder: s.Geocoder,
+		Backend:  s.Backend,
+		Units:    req.GetUnits(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toReply(req.GetAddress(), loc, fc, days(req.GetDays())), nil
+}
+
+// LookupBatch implements goforecastpb.GoforecastServer, streaming back one
+// reply per address as it completes.
+func (s *Server) LookupBatch(req *goforecastpb.LookupBatchRequest, stream goforecastpb.Goforecast_LookupBatchServer) error {
+	for _, addr := range req.GetAddresses() {
+		if addr == "" {
+			return status.Error(codes.InvalidArgument, "address is required")
+		}
+
+		loc, fc, err := resolver.Resolve(addr, resolver.Options{
+			Geocoder: s.Geocoder,
+			Backend:  s.Backend,
+			Units:    req.GetUnits(),
+		})
+		if err != nil {
+			return toStatus(err)
+		}
+
+		if err := stream.Send(toReply(addr, loc, fc, days(req.GetDays()))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toStatus maps a resolver error onto the gRPC status code that best
+// describes it: NotFound when geocoding turned up nothing, Unavailable when
+// an upstream provider returned a server error, and Unknown otherwise,
+// including a provider rejecting the request with a non-5xx error status.
+func toStatus(err error) error {
+	var upstream *providers.UpstreamError
+	switch {
+	case errors.Is(err, providers.ErrNoResults):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &upstream):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+// days returns n if positive, else defaultDays.
+func days(n int32) int {
+	if n <= 0 {
+		return defaultDays
+	}
+	return int(n)
+}
+
+// toReply normalizes a forecast.Forecast onto a goforecastpb.ForecastReply,
+// truncating the daily outlook to the requested number of days.
+func toReply(addr string, loc *providers.Location, fc *forecast.Forecast, days int) *goforecastpb.ForecastReply {
+	daily := fc.Daily.Data
+	if days < len(daily) {
+		daily = daily[:days]
+	}
+
+	return &goforecastpb.ForecastReply{
+		Address:  addr,
+		Location: &goforecastpb.Location{Lat: loc.Lat, Lng: loc.Lng},
+		Current:  toDataPoint(fc.Currently),
+		Daily:    toDataPoints(daily),
+		Hourly:   toDataPoints(fc.Hourly.Data),
+	}
+}
+
+func toDataPoints(data []forecast.DataPoint) []*goforecastpb.DataPoint {
+	out := make([]*goforecastpb.DataPoint, len(data))
+	for i, d := range data {
+		out[i] = toDataPoint(d)
+	}
+	return out
+}
+
+func toDataPoint(d forecast.DataPoint) *goforecastpb.DataPoint {
+	return &goforecastpb.DataPoint{
+		Time:              d.Time,
+		Summary:           d.Summary,
+		Temperature:       d.Temperature,
+		TemperatureMin:    d.TemperatureMin,
+		TemperatureMax:    d.TemperatureMax,
+		Pressure:          d.Pressure,
+		WindSpeed:         d.WindSpeed,
+		PrecipProbability: d.PrecipProbability,
+		PrecipType:        d.PrecipType,
+		SunriseTime:       d.SunriseTime,
+		SunsetTime:        d.SunsetTime,
+	}
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alextoombs/goforecast/providers"
+)
+
+func TestToStatus(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"no results", providers.ErrNoResults, codes.NotFound},
+		{"upstream error", &providers.UpstreamError{StatusCode: 503}, codes.Unavailable},
+		{"other error", errorString("boom"), codes.Unknown},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := status.Code(toStatus(c.err)); got != c.want {
+				t.Fatalf("toStatus(%v) code = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+}
+
+type errorString string
+
+func (e errorString) Error() string { return string(e) }
@@ -0,0 +1,118 @@
+// Package state persists small pieces of local configuration (API keys, and
+// friends) that goforecast needs between invocations. Everything lives in a
+// single JSON file so providers don't each need to invent their own on-disk
+// format.
+package state
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// fileName is the name of the file that contains persisted state.
+const fileName = ".goforecast"
+
+// path returns the path to the state file, evaluating $HOME on each call so
+// tests can override it with t.Setenv.
+func path() string {
+	return filepath.Join(os.Getenv("HOME"), fileName)
+}
+
+// State is the JSON struct that we read from/write to disk to keep state
+// across runs.
+type State struct {
+	// Keys maps a provider name (e.g. "google", "forecastio") to the API key
+	// that should be used for it.
+	Keys map[string]string `json:"keys"`
+
+	// LegacyAPIKey holds the value of a pre-multi-provider state file's
+	// "api_key" field. It's only ever populated by Restore, which migrates
+	// it into Keys[forecastio.Name] so upgrading users don't silently lose
+	// their forecast.io key; nothing should write to it going forward.
+	LegacyAPIKey string `json:"api_key,omitempty"`
+}
+
+// legacyForecastioKey is the provider name a pre-multi-provider state file's
+// bare "api_key" implicitly belonged to, since forecast.io was the only
+// backend before providers existed.
+const legacyForecastioKey = "forecastio"
+
+// Key looks up the API key for the given provider, preferring the persisted
+// state file and falling back to envKey in the environment.
+func Key(provider, envKey string) (string, error) {
+	s, err := Restore()
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+
+	if s != nil && s.Keys[provider] != "" {
+		return s.Keys[provider], nil
+	}
+
+	if k := os.Getenv(envKey); k != "" {
+		return k, nil
+	}
+	return "", nil
+}
+
+// SetKey persists the API key for the given provider, preserving any keys
+// already on disk for other providers.
+func SetKey(provider, key string) error {
+	s, err := Restore()
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if s == nil {
+		s = &State{}
+	}
+	if s.Keys == nil {
+		s.Keys = map[string]string{}
+	}
+	s.Keys[provider] = key
+	return Dump(s)
+}
+
+// Restore restores state from disk, migrating a pre-multi-provider file
+// (bare {"api_key": "..."}) onto Keys[legacyForecastioKey] in memory so an
+// upgrading user's existing forecast.io key keeps working without a
+// re-export. The migrated shape isn't written back until something else
+// calls Dump, e.g. via SetKey.
+func Restore() (*State, error) {
+	f, err := os.Open(path())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *State
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+
+	if s != nil && s.LegacyAPIKey != "" {
+		if s.Keys == nil {
+			s.Keys = map[string]string{}
+		}
+		if s.Keys[legacyForecastioKey] == "" {
+			s.Keys[legacyForecastioKey] = s.LegacyAPIKey
+		}
+		s.LegacyAPIKey = ""
+	}
+	return s, nil
+}
+
+// Dump writes state to disk.
+func Dump(s *State) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path(), b, 0644)
+}
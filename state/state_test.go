@@ -0,0 +1,67 @@
+package state
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestSetKeyGetKeyRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := SetKey("google", "abc123"); err != nil {
+		t.Fatalf("SetKey returned error: %s", err)
+	}
+
+	got, err := Key("google", "UNUSED_ENV_KEY")
+	if err != nil {
+		t.Fatalf("Key returned error: %s", err)
+	}
+	if got != "abc123" {
+		t.Fatalf("Expected key %q, got %q", "abc123", got)
+	}
+}
+
+func TestKeyFallsBackToEnv(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("SOME_ENV_KEY", "envvalue")
+
+	got, err := Key("google", "SOME_ENV_KEY")
+	if err != nil {
+		t.Fatalf("Key returned error: %s", err)
+	}
+	if got != "envvalue" {
+		t.Fatalf("Expected key %q, got %q", "envvalue", got)
+	}
+}
+
+func TestRestoreMigratesLegacyAPIKey(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := ioutil.WriteFile(path(), []byte(`{"api_key":"oldkey"}`), 0644); err != nil {
+		t.Fatalf("WriteFile returned error: %s", err)
+	}
+
+	got, err := Key(legacyForecastioKey, "UNUSED_ENV_KEY")
+	if err != nil {
+		t.Fatalf("Key returned error: %s", err)
+	}
+	if got != "oldkey" {
+		t.Fatalf("Expected migrated key %q, got %q", "oldkey", got)
+	}
+}
+
+func TestRestoreLegacyAPIKeyDoesNotOverrideExistingKey(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := ioutil.WriteFile(path(), []byte(`{"api_key":"oldkey","keys":{"forecastio":"newkey"}}`), 0644); err != nil {
+		t.Fatalf("WriteFile returned error: %s", err)
+	}
+
+	got, err := Key(legacyForecastioKey, "UNUSED_ENV_KEY")
+	if err != nil {
+		t.Fatalf("Key returned error: %s", err)
+	}
+	if got != "newkey" {
+		t.Fatalf("Expected existing key %q to win, got %q", "newkey", got)
+	}
+}
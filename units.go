@@ -0,0 +1,25 @@
+package main
+
+// unitLabels holds the display labels to render alongside forecast values
+// for a given units selection.
+type unitLabels struct {
+	Temp     string
+	Wind     string
+	Pressure string
+}
+
+// labelsFor returns the display labels for the given units string, which
+// mirrors the choices accepted by the forecast.io API: us, si, ca, uk, or
+// auto. It defaults to US customary units for anything else.
+func labelsFor(units string) unitLabels {
+	switch units {
+	case "si":
+		return unitLabels{Temp: "C", Wind: "kph", Pressure: "hPa"}
+	case "ca":
+		return unitLabels{Temp: "C", Wind: "kph", Pressure: "hPa"}
+	case "uk":
+		return unitLabels{Temp: "C", Wind: "mph", Pressure: "hPa"}
+	default:
+		return unitLabels{Temp: "F", Wind: "mph", Pressure: "kPa"}
+	}
+}
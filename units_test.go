@@ -0,0 +1,17 @@
+package main
+
+import "testing"
+
+func TestLabelsFor(t *testing.T) {
+	cases := map[string]unitLabels{
+		"us":      {Temp: "F", Wind: "mph", Pressure: "kPa"},
+		"si":      {Temp: "C", Wind: "kph", Pressure: "hPa"},
+		"unknown": {Temp: "F", Wind: "mph", Pressure: "kPa"},
+	}
+
+	for units, want := range cases {
+		if got := labelsFor(units); got != want {
+			t.Fatalf("labelsFor(%q) = %+v, want %+v", units, got, want)
+		}
+	}
+}